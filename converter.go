@@ -0,0 +1,85 @@
+package s2s
+
+import (
+	"errors"
+	"reflect"
+)
+
+// ErrInvalidConverterFunc is returned by [Converter.Register] when fn's
+// signature isn't func(*Src, *Dst) error.
+var ErrInvalidConverterFunc = errors.New("s2s: converter function must have signature func(*Src, *Dst) error")
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+type conversionKey struct {
+	src, dst reflect.Type
+}
+
+type conversionFunc func(src, dst interface{}) error
+
+// Converter is a registry of per-type-pair conversion functions, modeled on
+// k8s.io/apimachinery's conversion package. Where [MapStruct] always falls
+// back to a reflect-based field copy, a Converter lets you register an exact
+// function for a given (src, dst) type pair -- e.g. time.Time -> string, or
+// a protobuf timestamp -> time.Time -- and still falls back to the same
+// reflect-based copy (honoring its MapperConfig) for every other field.
+type Converter struct {
+	mapper *Mapper
+	fns    map[conversionKey]conversionFunc
+}
+
+// NewConverter creates a Converter that uses cfg for any field pair without
+// a registered conversion function. Field resolution for cfg is cached the
+// same way a [Mapper] caches it, so repeated Convert calls on the same type
+// pair -- including nested struct/slice/map fields, not just the top level
+// -- only pay for reflection once.
+func NewConverter(cfg MapperConfig) *Converter {
+	return &Converter{mapper: NewMapper(cfg), fns: map[conversionKey]conversionFunc{}}
+}
+
+// Register adds a conversion function for the (*Src, *Dst) pair inferred
+// from fn's signature, which must be func(src *Src, dst *Dst) error. It
+// replaces any function previously registered for the same pair.
+func (c *Converter) Register(fn interface{}) error {
+	fnVal := reflect.ValueOf(fn)
+	fnTyp := fnVal.Type()
+
+	if fnTyp.Kind() != reflect.Func || fnTyp.NumIn() != 2 || fnTyp.NumOut() != 1 ||
+		fnTyp.In(0).Kind() != reflect.Pointer || fnTyp.In(1).Kind() != reflect.Pointer ||
+		fnTyp.Out(0) != errorType {
+		return ErrInvalidConverterFunc
+	}
+
+	key := conversionKey{fnTyp.In(0).Elem(), fnTyp.In(1).Elem()}
+	c.fns[key] = func(src, dst interface{}) error {
+		out := fnVal.Call([]reflect.Value{reflect.ValueOf(src), reflect.ValueOf(dst)})
+		if err, ok := out[0].Interface().(error); ok {
+			return err
+		}
+		return nil
+	}
+
+	return nil
+}
+
+// lookup returns the registered conversion function for the exact
+// (srcTyp, dstTyp) pair, if any.
+func (c *Converter) lookup(srcTyp, dstTyp reflect.Type) (conversionFunc, bool) {
+	fn, ok := c.fns[conversionKey{srcTyp, dstTyp}]
+	return fn, ok
+}
+
+// Convert maps src onto dst the way [MapStructEx] would with c's
+// MapperConfig, except that any field pair -- or the top-level src/dst pair
+// itself -- whose types have a registered conversion function is delegated
+// to that function instead of the default reflect-based copy.
+func (c *Converter) Convert(src, dst interface{}) error {
+	if srcTyp, dstTyp := reflect.TypeOf(src), reflect.TypeOf(dst); srcTyp != nil && dstTyp != nil &&
+		srcTyp.Kind() == reflect.Pointer && dstTyp.Kind() == reflect.Pointer {
+		if fn, ok := c.lookup(srcTyp.Elem(), dstTyp.Elem()); ok {
+			return fn(src, dst)
+		}
+	}
+
+	return doMapStruct(c.mapper, src, dst, 0, map[visitKey]bool{}, c)
+}
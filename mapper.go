@@ -0,0 +1,142 @@
+package s2s
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// mapStep is a resolved field pairing between an input and output struct:
+// which field feeds which, with name resolution (tags, NameMapper,
+// SkipMissingField) already done.
+type mapStep struct {
+	iIndex []int
+	oIndex []int
+}
+
+// mapping is the precomputed plan for converting one (input type, output
+// type) pair under a fixed MapperConfig.
+type mapping struct {
+	steps []mapStep
+	err   error
+}
+
+// buildMapping walks iTyp and oTyp once, resolving every input field to the
+// output field it maps to (or recording the first missing-required-field
+// error). This is the work [Mapper] memoizes.
+func buildMapping(cfg MapperConfig, iTyp, oTyp reflect.Type) *mapping {
+	fieldMap := map[string]fieldSpec{}
+	for _, spec := range collectFields(cfg, oTyp) {
+		fieldMap[spec.Name] = spec
+	}
+
+	mp := &mapping{}
+	for _, iSpec := range collectFields(cfg, iTyp) {
+		oSpec, ok := fieldMap[iSpec.Name]
+		if !ok {
+			if !cfg.SkipMissingField || iSpec.Required {
+				mp.err = fmt.Errorf("%w: %q", ErrMissingField, iSpec.Name)
+				return mp
+			}
+			continue
+		}
+
+		mp.steps = append(mp.steps, mapStep{iIndex: iSpec.Index, oIndex: oSpec.Index})
+	}
+
+	return mp
+}
+
+// cfgFingerprint is the comparable subset of a MapperConfig, used as a cache
+// key since func values can't be compared directly. Hooks are recorded by
+// their code pointer only; closures created from the exact same func literal
+// (e.g. any two [CompositeMapper] or [ChainNameMappers] results) share a code
+// pointer regardless of what they capture, so [defaultMapperFor] does not
+// use the package-level cache for a config with a hook set.
+type cfgFingerprint struct {
+	nameMapper              uintptr
+	valueMapper             uintptr
+	skipMissingField        bool
+	skipFailedConversion    bool
+	mapNilToZeroImplicit    bool
+	allowImplicitConversion bool
+	tagName                 string
+	maxDepth                int
+}
+
+func fingerprint(cfg MapperConfig) cfgFingerprint {
+	fp := cfgFingerprint{
+		skipMissingField:        cfg.SkipMissingField,
+		skipFailedConversion:    cfg.SkipFailedConversion,
+		mapNilToZeroImplicit:    cfg.MapNilToZeroImplicit,
+		allowImplicitConversion: cfg.AllowImplicitConversion,
+		tagName:                 cfg.TagName,
+		maxDepth:                cfg.MaxDepth,
+	}
+	if cfg.NameMapper != nil {
+		fp.nameMapper = reflect.ValueOf(cfg.NameMapper).Pointer()
+	}
+	if cfg.ValueMapper != nil {
+		fp.valueMapper = reflect.ValueOf(cfg.ValueMapper).Pointer()
+	}
+	return fp
+}
+
+// typePair is the cache key for a single Mapper's memoized mappings.
+type typePair struct {
+	iTyp, oTyp reflect.Type
+}
+
+// Mapper memoizes the field resolution [MapStructEx] would otherwise redo
+// on every call, analogous to sqlx/reflectx.Mapper. Construct one with
+// [NewMapper] and reuse it across calls for the win; a Mapper is safe for
+// concurrent use.
+type Mapper struct {
+	cfg   MapperConfig
+	cache sync.Map // typePair -> *mapping
+}
+
+// NewMapper creates a Mapper bound to cfg. Calling Map on it behaves like
+// MapStructEx(cfg, ...), except that field metadata for a given (input,
+// output) type pair is only ever computed once.
+func NewMapper(cfg MapperConfig) *Mapper {
+	return &Mapper{cfg: normalizeConfig(cfg)}
+}
+
+func (m *Mapper) mappingFor(iTyp, oTyp reflect.Type) *mapping {
+	key := typePair{iTyp, oTyp}
+	if cached, ok := m.cache.Load(key); ok {
+		return cached.(*mapping)
+	}
+
+	built := buildMapping(m.cfg, iTyp, oTyp)
+	actual, _ := m.cache.LoadOrStore(key, built)
+	return actual.(*mapping)
+}
+
+// Map maps input onto output using m's MapperConfig.
+func (m *Mapper) Map(input, output interface{}) error {
+	return doMapStruct(m, input, output, 0, map[visitKey]bool{}, nil)
+}
+
+// defaultMappers backs [MapStruct] and [MapStructEx]: one Mapper per
+// distinct hook-free MapperConfig fingerprint, each with its own type-pair
+// cache. A config with a NameMapper or ValueMapper gets a fresh Mapper per
+// call instead, so its own hooks are always the ones used.
+var defaultMappers sync.Map // cfgFingerprint -> *Mapper
+
+func defaultMapperFor(cfg MapperConfig) *Mapper {
+	cfg = normalizeConfig(cfg)
+	if cfg.NameMapper != nil || cfg.ValueMapper != nil {
+		return &Mapper{cfg: cfg}
+	}
+	fp := fingerprint(cfg)
+
+	if cached, ok := defaultMappers.Load(fp); ok {
+		return cached.(*Mapper)
+	}
+
+	built := &Mapper{cfg: cfg}
+	actual, _ := defaultMappers.LoadOrStore(fp, built)
+	return actual.(*Mapper)
+}
@@ -3,6 +3,7 @@ package s2s
 import (
 	"reflect"
 	"strings"
+	"unicode"
 )
 
 //Mapper that ignores capitalization in names
@@ -10,6 +11,98 @@ func CaseInsensitiveMapper(s string) string {
 	return strings.ToLower(s)
 }
 
+//splitWords breaks s into its constituent words the way Go's own naming
+//convention would: a lower-to-upper transition starts a new word ("userID"
+//-> "user", "ID"), and within a run of uppercase letters, a new word starts
+//at the last uppercase letter before a lowercase one ("HTTPServer" ->
+//"HTTP", "Server"). Uses unicode.IsUpper/IsLower so it isn't ASCII-only.
+func splitWords(s string) []string {
+	runes := []rune(s)
+	if len(runes) == 0 {
+		return nil
+	}
+
+	var words []string
+	start := 0
+	for i := 1; i < len(runes); i++ {
+		cur, prev := runes[i], runes[i-1]
+		switch {
+		case unicode.IsUpper(cur) && unicode.IsLower(prev):
+			words = append(words, string(runes[start:i]))
+			start = i
+		case unicode.IsUpper(cur) && unicode.IsUpper(prev) && i+1 < len(runes) && unicode.IsLower(runes[i+1]):
+			words = append(words, string(runes[start:i]))
+			start = i
+		}
+	}
+	words = append(words, string(runes[start:]))
+
+	return words
+}
+
+//titleWord upper-cases the first rune of w and leaves the rest untouched,
+//so an already-uppercase acronym ("ID") survives unchanged.
+func titleWord(w string) string {
+	if w == "" {
+		return w
+	}
+	r := []rune(w)
+	return strings.ToUpper(string(r[0])) + string(r[1:])
+}
+
+//Mapper that converts CamelCase/PascalCase names to snake_case, splitting
+//acronym runs as their own word ("HTTPServer" -> "http_server")
+func SnakeCaseMapper(s string) string {
+	return strings.ToLower(strings.Join(splitWords(s), "_"))
+}
+
+//Mapper that converts names to ALL_CAPS_SNAKE_CASE
+func AllCapsUnderscoreMapper(s string) string {
+	return strings.ToUpper(strings.Join(splitWords(s), "_"))
+}
+
+//Mapper that converts names to kebab-case
+func KebabCaseMapper(s string) string {
+	return strings.ToLower(strings.Join(splitWords(s), "-"))
+}
+
+//Mapper that converts names to PascalCase
+func PascalCaseMapper(s string) string {
+	words := splitWords(s)
+	parts := make([]string, len(words))
+	for i, w := range words {
+		parts[i] = titleWord(w)
+	}
+	return strings.Join(parts, "")
+}
+
+//Mapper that converts names to camelCase, lower-casing a leading acronym
+//("HTTPServer" -> "httpServer")
+func CamelCaseMapper(s string) string {
+	words := splitWords(s)
+	if len(words) == 0 {
+		return s
+	}
+
+	parts := make([]string, len(words))
+	parts[0] = strings.ToLower(words[0])
+	for i := 1; i < len(words); i++ {
+		parts[i] = titleWord(words[i])
+	}
+	return strings.Join(parts, "")
+}
+
+//Used to chain together multiple [NameMapper] in the given order, each
+//fed the previous one's output
+func ChainNameMappers(mappers ...NameMapper) NameMapper {
+	return func(s string) string {
+		for _, m := range mappers {
+			s = m(s)
+		}
+		return s
+	}
+}
+
 //Mapper that maps pointer types to direct types *T -> T using Zero(T) for nil
 func MapPtrToVal(v reflect.Value, t reflect.Type) interface{} {
 	if v.Kind() == reflect.Pointer && v.Type().Elem() == t {
@@ -6,11 +6,17 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 )
 
 var ErrArgumentsInvalid = errors.New("input or output argument not pointer to struct")
 var ErrInvalidConversion = errors.New("ValueMapper return can't be assigned to targetType")
 var ErrMissingField = errors.New("Destination struct is missing field")
+var ErrMaxDepthExceeded = errors.New("s2s: MaxDepth exceeded while mapping nested value")
+var ErrCycleDetected = errors.New("s2s: cycle detected while mapping nested value")
+
+// defaultMaxDepth is used when MapperConfig.MaxDepth is unset (<=0).
+const defaultMaxDepth = 32
 
 // Maps field names to allow flexibility in mapping
 type NameMapper = func(string) string
@@ -22,20 +28,20 @@ type ValueMapper = func(value reflect.Value, targetType reflect.Type) interface{
 type MapperConfig struct {
 	// This function if set will be called every time a field name is used
 	// allowing you to map handle conversions like CamelCase -> snake_case
-	NameMapper           NameMapper
+	NameMapper NameMapper
 
-	// This function if set will be called when mapping an input value 
+	// This function if set will be called when mapping an input value
 	// to an output fields type.
 	// For convenience you are allowed to return both a value directly or
 	// behind a pointer.
 	// Also if `MapNilToZeroImplicit` is set (default), returned typed nil
 	// pointers will be implicitly mapped to the zero value if the output
 	// field is not of pointer type
-	ValueMapper          ValueMapper
+	ValueMapper ValueMapper
 
 	// If set (default) the mapper will not error on fields that can't be
 	// mapped to an output field
-	SkipMissingField     bool
+	SkipMissingField bool
 
 	// If set (default) the mapper will not error if a conversion from input
 	// field type to output field type fails
@@ -44,6 +50,26 @@ type MapperConfig struct {
 	// If set (default) the mapper will implicitly convert typed nil pointers
 	// to zero values of the type as needed
 	MapNilToZeroImplicit bool
+
+	// If set, fields that aren't directly assignable but whose type is
+	// reflect.Type.ConvertibleTo the output field's type (e.g. int -> int64,
+	// float32 -> float64, []byte <-> string, or a named type over the same
+	// underlying kind) are converted with reflect.Value.Convert instead of
+	// being treated as a failed conversion. Off by default, since
+	// ConvertibleTo is permissive enough to be surprising in at least one
+	// case: an int -> string conversion follows Go's rune-conversion rule
+	// (int(42) becomes "*", not "42"), not a decimal format.
+	AllowImplicitConversion bool
+
+	// TagName is the struct tag key consulted for per-field overrides,
+	// e.g. `s2s:"other_name"`, `s2s:"-"`, `s2s:",required"` and
+	// `s2s:",inline"`. A tag-derived name takes priority over NameMapper
+	// on both the input and output side. Defaults to "s2s".
+	TagName string
+
+	// MaxDepth bounds how many levels of nested structs, slices, arrays
+	// and maps will be descended into. Defaults to 32 when <= 0.
+	MaxDepth int
 }
 
 var DefaultConfig = MapperConfig{
@@ -52,20 +78,123 @@ var DefaultConfig = MapperConfig{
 	SkipMissingField:     true,
 	SkipFailedConversion: true,
 	MapNilToZeroImplicit: true,
+	TagName:              "s2s",
+	MaxDepth:             defaultMaxDepth,
+}
+
+func effectiveMaxDepth(cfg MapperConfig) int {
+	if cfg.MaxDepth <= 0 {
+		return defaultMaxDepth
+	}
+	return cfg.MaxDepth
+}
+
+// normalizeConfig fills in cfg's defaulted fields so that two configs which
+// behave identically also compare identically once fingerprinted.
+func normalizeConfig(cfg MapperConfig) MapperConfig {
+	if cfg.TagName == "" {
+		cfg.TagName = DefaultConfig.TagName
+	}
+	cfg.MaxDepth = effectiveMaxDepth(cfg)
+	return cfg
+}
+
+// fieldTag is the parsed form of a MapperConfig.TagName struct tag.
+type fieldTag struct {
+	Name     string
+	Skip     bool
+	Required bool
+	Inline   bool
+}
+
+func parseFieldTag(f reflect.StructField, tagName string) fieldTag {
+	raw, ok := f.Tag.Lookup(tagName)
+	if !ok {
+		return fieldTag{}
+	}
+
+	parts := strings.Split(raw, ",")
+	tag := fieldTag{Name: parts[0]}
+	if tag.Name == "-" {
+		tag.Skip = true
+		tag.Name = ""
+	}
+
+	for _, opt := range parts[1:] {
+		switch opt {
+		case "required":
+			tag.Required = true
+		case "inline":
+			tag.Inline = true
+		}
+	}
+
+	return tag
+}
+
+// fieldSpec is a leaf field reachable from a struct, possibly through one
+// or more embedded or `s2s:",inline"` fields. Index is the path [FieldByIndex]
+// needs to reach it.
+type fieldSpec struct {
+	Field    reflect.StructField
+	Index    []int
+	Name     string
+	Required bool
+}
+
+// collectFields flattens typ's fields, descending into embedded fields and
+// fields tagged `s2s:",inline"` as if their fields were declared directly
+// on typ. Fields tagged `s2s:"-"` are omitted entirely.
+func collectFields(cfg MapperConfig, typ reflect.Type) []fieldSpec {
+	var specs []fieldSpec
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		tag := parseFieldTag(f, cfg.TagName)
+		if tag.Skip {
+			continue
+		}
+
+		if f.Type.Kind() == reflect.Struct && (f.Anonymous || tag.Inline) {
+			for _, nested := range collectFields(cfg, f.Type) {
+				nested.Index = append([]int{i}, nested.Index...)
+				specs = append(specs, nested)
+			}
+			continue
+		}
+
+		name := f.Name
+		if cfg.NameMapper != nil {
+			name = cfg.NameMapper(name)
+		}
+		if tag.Name != "" {
+			name = tag.Name
+		}
+
+		specs = append(specs, fieldSpec{Field: f, Index: []int{i}, Name: name, Required: tag.Required})
+	}
+
+	return specs
+}
+
+// visitKey identifies a (pointer, type) pair currently being mapped, so
+// self-referential graphs can be detected the way [reflect.DeepEqual] does.
+type visitKey struct {
+	ptr uintptr
+	typ reflect.Type
 }
 
 // MapStruct takes a pointer to an input struct and a pointer to an output struct.
 // It will perform a mapping using the default options
 func MapStruct(input interface{}, output interface{}) error {
-	return mapStruct(DefaultConfig, input, output)
+	return defaultMapperFor(DefaultConfig).Map(input, output)
 }
 
 // MapStructEx takes an additional argument compared to [MapStruct] for configuration.
 func MapStructEx(cfg MapperConfig, input interface{}, output interface{}) error {
-	return mapStruct(cfg, input, output)
+	return defaultMapperFor(cfg).Map(input, output)
 }
 
-func mapStruct(cfg MapperConfig, input interface{}, output interface{}) error {
+func doMapStruct(m *Mapper, input interface{}, output interface{}, depth int, visited map[visitKey]bool, conv *Converter) error {
 	iTyp, oTyp := reflect.TypeOf(input), reflect.TypeOf(output)
 	// Handle untyped nil
 	if iTyp == nil || oTyp == nil {
@@ -89,69 +218,267 @@ func mapStruct(cfg MapperConfig, input interface{}, output interface{}) error {
 		return ErrArgumentsInvalid
 	}
 
-	fieldMap := map[string]int{}
-	for i := 0; i < oTyp.NumField(); i++ {
-		f := oTyp.Field(i)
-		mappedName := f.Name
-		if cfg.NameMapper != nil {
-			mappedName = cfg.NameMapper(mappedName)
+	if depth > effectiveMaxDepth(m.cfg) {
+		return ErrMaxDepthExceeded
+	}
+
+	mp := m.mappingFor(iTyp, oTyp)
+	if mp.err != nil {
+		return mp.err
+	}
+
+	for _, step := range mp.steps {
+		iFieldVal := iVal.FieldByIndex(step.iIndex)
+		oFieldVal := oVal.FieldByIndex(step.oIndex)
+		if err := mapOneField(m, depth, visited, conv, iFieldVal, oFieldVal); err != nil {
+			return err
 		}
-		fieldMap[mappedName] = i
 	}
 
-	for i := 0; i < iVal.NumField(); i++ {
-		iField := iTyp.Field(i)
-		iFieldVal := iVal.Field(i)
-		mappedName := iField.Name
-		if cfg.NameMapper != nil {
-			mappedName = cfg.NameMapper(mappedName)
+	return nil
+}
+
+// mapOneField maps a single resolved field pair, applying the Converter
+// lookup, ValueMapper hook, assignability/pointer convenience rules, nested
+// struct/slice/array/map recursion, AllowImplicitConversion and finally
+// SkipFailedConversion in that order.
+func mapOneField(m *Mapper, depth int, visited map[visitKey]bool, conv *Converter, iFieldVal, oFieldVal reflect.Value) error {
+	// Addr() requires an addressable value on both sides. That's always
+	// true for a struct field of an addressable struct, but not for a
+	// value whose parent wasn't addressable to begin with -- fall through to
+	// the rest of this function for those instead of panicking.
+	if conv != nil && iFieldVal.CanAddr() && oFieldVal.CanAddr() {
+		if fn, ok := conv.lookup(iFieldVal.Type(), oFieldVal.Type()); ok {
+			return fn(iFieldVal.Addr().Interface(), oFieldVal.Addr().Interface())
 		}
+	}
+
+	cfg := m.cfg
+	mappedInput := iFieldVal.Interface()
+	if cfg.ValueMapper != nil {
+		mappedInput = cfg.ValueMapper(iFieldVal, oFieldVal.Type())
+	}
+	mappedInputVal := reflect.ValueOf(mappedInput)
 
-		oIdx, ok := fieldMap[mappedName]
-		if !ok {
-			if !cfg.SkipMissingField {
-				return fmt.Errorf("%w: %q", ErrMissingField, mappedName)
+	//Decision: Don't modify anything if the returned type is directly assignable
+	if mappedInputVal.Type().AssignableTo(oFieldVal.Type()) {
+		oFieldVal.Set(mappedInputVal)
+		return nil
+	}
+
+	if mappedInputVal.Kind() == reflect.Pointer && mappedInputVal.Type().Elem().AssignableTo(oFieldVal.Type()) {
+		//Convenience: Allow ValueMapper to return either oField.Type or *oField.Type
+		if mappedInputVal.IsNil() {
+			if !cfg.MapNilToZeroImplicit {
+				return fmt.Errorf("%w: Can't map <nil>(%s) to (%s)", ErrInvalidConversion, mappedInputVal.Type().Name(), oFieldVal.Type())
 			}
-			continue
+			oFieldVal.Set(reflect.Zero(oFieldVal.Type()))
+		} else {
+			oFieldVal.Set(mappedInputVal.Elem())
 		}
+		return nil
+	}
 
-		oField := oTyp.Field(oIdx)
-		oFieldVal := oVal.Field(oIdx)
+	if reflect.PointerTo(mappedInputVal.Type()).AssignableTo(oFieldVal.Type()) {
+		//Decision: Do not modify the things that the output object already points to
+		//Convenience: If oField has type *mappedInputVal.Type, allocate a new one
+		valHolder := reflect.New(mappedInputVal.Type())
+		valHolder.Elem().Set(mappedInputVal)
+		oFieldVal.Set(valHolder)
+		return nil
+	}
 
-		mappedInput := iFieldVal.Interface()
-		if cfg.ValueMapper != nil {
-			mappedInput = cfg.ValueMapper(iFieldVal, oField.Type)
+	// Nested struct/slice/array/map that isn't directly assignable: recurse
+	// instead of giving up. Errors surfaced here (including from deeper
+	// recursion) are not subject to SkipFailedConversion, the same way
+	// top-level doMapStruct errors always propagate.
+	if converted, handled, err := convertNested(m, depth, visited, mappedInputVal, oFieldVal.Type(), conv); handled {
+		if err != nil {
+			return err
 		}
-		mappedInputVal := reflect.ValueOf(mappedInput)
-
-		//Decision: Don't modify anything if the returned type is directly assignable
-		if !mappedInputVal.Type().AssignableTo(oFieldVal.Type()) {
-			if mappedInputVal.Kind() == reflect.Pointer && mappedInputVal.Type().Elem().AssignableTo(oFieldVal.Type()) {
-				//Convenience: Allow ValueMapper to return either oField.Type or *oField.Type
-				if mappedInputVal.IsNil() {
-					if !cfg.MapNilToZeroImplicit {
-						return fmt.Errorf("%w: Can't map <nil>(%s) to (%s)", ErrInvalidConversion, mappedInputVal.Type().Name(), oFieldVal.Type()) 
-					}
-					mappedInputVal = reflect.Zero(oFieldVal.Type())
-				} else {
-					mappedInputVal = mappedInputVal.Elem()
-				}
-			} else if reflect.PointerTo(mappedInputVal.Type()).AssignableTo(oFieldVal.Type()) {
-				//Decision: Do not modify the things that the output object already points to
-				//Convenience: If oField has type *mappedInputVal.Type, allocate a new one
-				valHolder := reflect.New(mappedInputVal.Type())
-				valHolder.Elem().Set(mappedInputVal)
-				mappedInputVal = valHolder
-			} else {
-				if cfg.SkipFailedConversion {
-					continue
-				}
-				return ErrInvalidConversion
-			}
+		oFieldVal.Set(converted)
+		return nil
+	}
+
+	//Convenience: with AllowImplicitConversion, fall back to reflect.Convert
+	//for types that aren't assignable but are convertible, e.g. int -> int64,
+	//float32 -> float64, int -> string, []byte <-> string or a named type
+	//over the same underlying kind.
+	if cfg.AllowImplicitConversion && mappedInputVal.Type().ConvertibleTo(oFieldVal.Type()) {
+		oFieldVal.Set(mappedInputVal.Convert(oFieldVal.Type()))
+		return nil
+	}
+
+	if cfg.SkipFailedConversion {
+		return nil
+	}
+	return ErrInvalidConversion
+}
+
+// convertNested attempts to map iv onto oType by recursing into nested
+// structs, slices, arrays or string-keyed maps. handled reports whether iv
+// and oType matched one of those shapes at all; when handled is false the
+// caller should fall back to its own failed-conversion handling.
+func convertNested(m *Mapper, depth int, visited map[visitKey]bool, iv reflect.Value, oType reflect.Type, conv *Converter) (reflect.Value, bool, error) {
+	switch {
+	case iv.Kind() == reflect.Struct && oType.Kind() == reflect.Struct:
+		v, err := convertStruct(m, depth, visited, iv, oType, conv)
+		return v, true, err
+
+	case iv.Kind() == reflect.Pointer && oType.Kind() == reflect.Pointer &&
+		iv.Type().Elem().Kind() == reflect.Struct && oType.Elem().Kind() == reflect.Struct:
+		v, err := convertStructPtr(m, depth, visited, iv, oType, conv)
+		return v, true, err
+
+	case (iv.Kind() == reflect.Slice || iv.Kind() == reflect.Array) &&
+		(oType.Kind() == reflect.Slice || oType.Kind() == reflect.Array):
+		v, err := convertSequence(m, depth, visited, iv, oType, conv)
+		return v, true, err
+
+	case iv.Kind() == reflect.Map && oType.Kind() == reflect.Map &&
+		iv.Type().Key().Kind() == reflect.String && oType.Key().Kind() == reflect.String:
+		v, err := convertMap(m, depth, visited, iv, oType, conv)
+		return v, true, err
+	}
+
+	return reflect.Value{}, false, nil
+}
+
+// convertStruct maps an addressable struct value onto a freshly allocated
+// oType by recursing through [doMapStruct] with the same Mapper, so nested
+// struct fields are resolved through the same cache as the top level.
+func convertStruct(m *Mapper, depth int, visited map[visitKey]bool, iv reflect.Value, oType reflect.Type, conv *Converter) (reflect.Value, error) {
+	if depth >= effectiveMaxDepth(m.cfg) {
+		return reflect.Value{}, ErrMaxDepthExceeded
+	}
+
+	ivPtr := addrOf(iv)
+
+	if iv.CanAddr() {
+		key := visitKey{iv.Addr().Pointer(), iv.Type()}
+		if visited[key] {
+			return reflect.Value{}, ErrCycleDetected
 		}
+		visited[key] = true
+		defer delete(visited, key)
+	}
 
-		oFieldVal.Set(mappedInputVal)
+	ov := reflect.New(oType)
+	if err := doMapStruct(m, ivPtr.Interface(), ov.Interface(), depth+1, visited, conv); err != nil {
+		return reflect.Value{}, err
 	}
 
-	return nil
+	return ov.Elem(), nil
+}
+
+// convertStructPtr is [convertStruct] for *struct -> *struct fields, tracking
+// cycles by the input pointer's identity rather than an address we took
+// ourselves.
+func convertStructPtr(m *Mapper, depth int, visited map[visitKey]bool, iv reflect.Value, oType reflect.Type, conv *Converter) (reflect.Value, error) {
+	if iv.IsNil() {
+		return reflect.Zero(oType), nil
+	}
+
+	if depth >= effectiveMaxDepth(m.cfg) {
+		return reflect.Value{}, ErrMaxDepthExceeded
+	}
+
+	key := visitKey{iv.Pointer(), iv.Type()}
+	if visited[key] {
+		return reflect.Value{}, ErrCycleDetected
+	}
+	visited[key] = true
+	defer delete(visited, key)
+
+	ov := reflect.New(oType.Elem())
+	if err := doMapStruct(m, iv.Interface(), ov.Interface(), depth+1, visited, conv); err != nil {
+		return reflect.Value{}, err
+	}
+
+	return ov, nil
+}
+
+// convertSequence maps a slice or array onto a destination slice of matching
+// length, or onto a destination array of its own fixed length (extra input
+// elements are dropped, missing ones stay zero), mapping each element pair.
+func convertSequence(m *Mapper, depth int, visited map[visitKey]bool, iv reflect.Value, oType reflect.Type, conv *Converter) (reflect.Value, error) {
+	if depth >= effectiveMaxDepth(m.cfg) {
+		return reflect.Value{}, ErrMaxDepthExceeded
+	}
+
+	// An array copied out through Interface() isn't addressable; work on an
+	// addressable copy so its elements can be handed to a Converter.
+	if !iv.CanAddr() {
+		iv = addrOf(iv).Elem()
+	}
+
+	n := iv.Len()
+
+	var dst reflect.Value
+	if oType.Kind() == reflect.Slice {
+		dst = reflect.MakeSlice(oType, n, n)
+	} else {
+		dst = reflect.New(oType).Elem()
+		if n > dst.Len() {
+			n = dst.Len()
+		}
+	}
+
+	for i := 0; i < n; i++ {
+		// Route each element through the same decision process as a struct
+		// field (Converter lookup, ValueMapper, assignability, pointer
+		// convenience, nested recursion, AllowImplicitConversion, and
+		// finally SkipFailedConversion) instead of a bare AssignableTo
+		// check, so e.g. a registered Converter or AllowImplicitConversion
+		// applies to element types too, and a genuinely unconvertible
+		// element is skipped rather than hard-failing the whole field.
+		if err := mapOneField(m, depth+1, visited, conv, iv.Index(i), dst.Index(i)); err != nil {
+			return reflect.Value{}, err
+		}
+	}
+
+	return dst, nil
+}
+
+// convertMap maps a string-keyed map onto a destination map, running keys
+// through NameMapper and recursively mapping values.
+func convertMap(m *Mapper, depth int, visited map[visitKey]bool, iv reflect.Value, oType reflect.Type, conv *Converter) (reflect.Value, error) {
+	if depth >= effectiveMaxDepth(m.cfg) {
+		return reflect.Value{}, ErrMaxDepthExceeded
+	}
+
+	dst := reflect.MakeMapWithSize(oType, iv.Len())
+	iter := iv.MapRange()
+	for iter.Next() {
+		name := iter.Key().String()
+		if m.cfg.NameMapper != nil {
+			name = m.cfg.NameMapper(name)
+		}
+
+		// Map values aren't addressable, so copy into an addressable temp
+		// on both sides and route through mapOneField -- see convertSequence.
+		inElem := reflect.New(iv.Type().Elem()).Elem()
+		inElem.Set(iter.Value())
+		outElem := reflect.New(oType.Elem()).Elem()
+
+		if err := mapOneField(m, depth+1, visited, conv, inElem, outElem); err != nil {
+			return reflect.Value{}, err
+		}
+
+		dst.SetMapIndex(reflect.ValueOf(name).Convert(oType.Key()), outElem)
+	}
+
+	return dst, nil
+}
+
+// addrOf returns an addressable pointer to a copy of v, taking v's own
+// address when possible to avoid an extra allocation.
+func addrOf(v reflect.Value) reflect.Value {
+	if v.CanAddr() {
+		return v.Addr()
+	}
+
+	ptr := reflect.New(v.Type())
+	ptr.Elem().Set(v)
+	return ptr
 }
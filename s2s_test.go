@@ -3,6 +3,7 @@ package s2s
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -72,6 +73,30 @@ type EmbeddedFrom struct {
 var exampleFrom = SimpleFrom{true, "test", -10, 20, 3.14, 5 + 12i}
 var expectedTo = SimpleTo{true, "test", -10, 20, 3.14, 5 + 12i}
 
+type TaggedFrom struct {
+	Bool   bool   `s2s:"b"`
+	String string `s2s:"-"`
+	Secret string
+}
+
+type TaggedTo struct {
+	B      bool `s2s:"b"`
+	String string
+	Secret string `s2s:"-"`
+}
+
+type InlineInner struct {
+	Int int
+}
+
+type InlineFrom struct {
+	Inner InlineInner `s2s:",inline"`
+}
+
+type InlineTo struct {
+	Int int
+}
+
 func TestBasic(t *testing.T) {
 	// The &s everywhere look nasty
 	testMap := []TestCase{
@@ -150,6 +175,399 @@ func TestEmbeds(t *testing.T) {
 	})
 }
 
+func TestTags(t *testing.T) {
+	t.Run("tag rename takes priority over matching field name", func(t *testing.T) {
+		var to TaggedTo
+		err := MapStruct(&TaggedFrom{Bool: true, String: "skip me", Secret: "untouched"}, &to)
+		assert.Nil(t, err)
+		assert.Equal(t, TaggedTo{B: true, String: "", Secret: ""}, to)
+	})
+
+	t.Run("s2s:\"-\" skips the field", func(t *testing.T) {
+		var to TaggedTo
+		to.Secret = "kept"
+		err := MapStruct(&TaggedFrom{Secret: "overwritten?"}, &to)
+		assert.Nil(t, err)
+		assert.Equal(t, "kept", to.Secret)
+	})
+
+	t.Run("s2s:\",inline\" flattens a non-embedded struct field", func(t *testing.T) {
+		var to InlineTo
+		err := MapStruct(&InlineFrom{InlineInner{42}}, &to)
+		assert.Nil(t, err)
+		assert.Equal(t, InlineTo{42}, to)
+	})
+
+	t.Run("s2s:\",required\" overrides SkipMissingField", func(t *testing.T) {
+		type reqFrom struct {
+			Missing int `s2s:",required"`
+		}
+		err := MapStructEx(DefaultConfig, &reqFrom{Missing: 1}, &struct{}{})
+		assert.ErrorIs(t, err, ErrMissingField)
+	})
+
+	t.Run("custom TagName", func(t *testing.T) {
+		type from struct {
+			Val int `db:"Other"`
+		}
+		type to struct {
+			Other int
+		}
+		cfg := DefaultConfig
+		cfg.TagName = "db"
+		var out to
+		err := MapStructEx(cfg, &from{Val: 7}, &out)
+		assert.Nil(t, err)
+		assert.Equal(t, to{7}, out)
+	})
+}
+
+type NestedAddrFrom struct {
+	City string
+}
+type NestedAddrTo struct {
+	City string
+}
+type NestedFrom struct {
+	Name    string
+	Addr    NestedAddrFrom
+	Tags    []string
+	Nums    [3]int
+	Meta    map[string]string
+	Friends []NestedAddrFrom
+}
+type NestedTo struct {
+	Name    string
+	Addr    NestedAddrTo
+	Tags    []string
+	Nums    [3]int
+	Meta    map[string]string
+	Friends []NestedAddrTo
+}
+
+type LinkedFrom struct {
+	Val  int
+	Next *LinkedFrom
+}
+type LinkedTo struct {
+	Val  int
+	Next *LinkedTo
+}
+
+func TestNestedMapping(t *testing.T) {
+	t.Run("nested structs, slices, arrays and maps are mapped recursively", func(t *testing.T) {
+		from := NestedFrom{
+			Name:    "joe",
+			Addr:    NestedAddrFrom{City: "NYC"},
+			Tags:    []string{"a", "b"},
+			Nums:    [3]int{1, 2, 3},
+			Meta:    map[string]string{"k": "v"},
+			Friends: []NestedAddrFrom{{City: "LA"}, {City: "SF"}},
+		}
+		var to NestedTo
+		err := MapStruct(&from, &to)
+		assert.Nil(t, err)
+		assert.Equal(t, NestedTo{
+			Name:    "joe",
+			Addr:    NestedAddrTo{City: "NYC"},
+			Tags:    []string{"a", "b"},
+			Nums:    [3]int{1, 2, 3},
+			Meta:    map[string]string{"k": "v"},
+			Friends: []NestedAddrTo{{City: "LA"}, {City: "SF"}},
+		}, to)
+	})
+
+	t.Run("*struct fields recurse, nil stays nil", func(t *testing.T) {
+		from := LinkedFrom{Val: 1, Next: &LinkedFrom{Val: 2}}
+		var to LinkedTo
+		err := MapStruct(&from, &to)
+		assert.Nil(t, err)
+		assert.Equal(t, LinkedTo{Val: 1, Next: &LinkedTo{Val: 2}}, to)
+	})
+
+	t.Run("cycles are detected instead of recursing forever", func(t *testing.T) {
+		a := &LinkedFrom{Val: 1}
+		b := &LinkedFrom{Val: 2}
+		a.Next = b
+		b.Next = a
+
+		var to LinkedTo
+		err := MapStruct(a, &to)
+		assert.ErrorIs(t, err, ErrCycleDetected)
+	})
+
+	t.Run("MaxDepth bounds recursion", func(t *testing.T) {
+		cfg := DefaultConfig
+		cfg.MaxDepth = 1
+		var to LinkedTo
+		err := MapStructEx(cfg, &LinkedFrom{Val: 1, Next: &LinkedFrom{Val: 2, Next: &LinkedFrom{Val: 3}}}, &to)
+		assert.ErrorIs(t, err, ErrMaxDepthExceeded)
+	})
+
+	t.Run("a slice field with unconvertible element types is skipped like any other failed conversion", func(t *testing.T) {
+		type mismatchFrom struct{ Vals []string }
+		type mismatchTo struct{ Vals []chan int }
+
+		var to mismatchTo
+		err := MapStruct(&mismatchFrom{Vals: []string{"a", "b"}}, &to)
+		assert.Nil(t, err)
+
+		cfg := DefaultConfig
+		cfg.SkipFailedConversion = false
+		var to2 mismatchTo
+		err = MapStructEx(cfg, &mismatchFrom{Vals: []string{"a"}}, &to2)
+		assert.ErrorIs(t, err, ErrInvalidConversion)
+	})
+}
+
+type TimestampSrc struct {
+	When int64 // unix seconds
+	Name string
+}
+type TimestampDst struct {
+	When string
+	Name string
+}
+
+// myInt is a named type over int, used to exercise AllowImplicitConversion
+// for named types sharing an underlying kind.
+type myInt int
+
+func TestConverter(t *testing.T) {
+	t.Run("registered conversion function is used for the matching field pair", func(t *testing.T) {
+		conv := NewConverter(DefaultConfig)
+		err := conv.Register(func(src *int64, dst *string) error {
+			*dst = time.Unix(*src, 0).UTC().Format(time.RFC3339)
+			return nil
+		})
+		assert.Nil(t, err)
+
+		src := TimestampSrc{When: 1577934245, Name: "x"}
+		var dst TimestampDst
+		err = conv.Convert(&src, &dst)
+		assert.Nil(t, err)
+		assert.Equal(t, TimestampDst{When: "2020-01-02T03:04:05Z", Name: "x"}, dst)
+	})
+
+	t.Run("fields without a registered function fall back to the default copy", func(t *testing.T) {
+		conv := NewConverter(DefaultConfig)
+		var dst SimpleTo
+		err := conv.Convert(&exampleFrom, &dst)
+		assert.Nil(t, err)
+		assert.Equal(t, expectedTo, dst)
+	})
+
+	t.Run("Register rejects the wrong function shape", func(t *testing.T) {
+		conv := NewConverter(DefaultConfig)
+		err := conv.Register(func(a int, b int) error { return nil })
+		assert.ErrorIs(t, err, ErrInvalidConverterFunc)
+	})
+
+	t.Run("a registered conversion function also applies to slice and map elements", func(t *testing.T) {
+		type timesFrom struct{ Times []int64 }
+		type timesTo struct{ Times []string }
+		type metaFrom struct{ Meta map[string]int64 }
+		type metaTo struct{ Meta map[string]string }
+
+		conv := NewConverter(DefaultConfig)
+		err := conv.Register(func(src *int64, dst *string) error {
+			*dst = time.Unix(*src, 0).UTC().Format(time.RFC3339)
+			return nil
+		})
+		assert.Nil(t, err)
+
+		var sliceDst timesTo
+		err = conv.Convert(&timesFrom{Times: []int64{1577934245, 1577934246}}, &sliceDst)
+		assert.Nil(t, err)
+		assert.Equal(t, timesTo{Times: []string{"2020-01-02T03:04:05Z", "2020-01-02T03:04:06Z"}}, sliceDst)
+
+		var mapDst metaTo
+		err = conv.Convert(&metaFrom{Meta: map[string]int64{"k": 1577934245}}, &mapDst)
+		assert.Nil(t, err)
+		assert.Equal(t, metaTo{Meta: map[string]string{"k": "2020-01-02T03:04:05Z"}}, mapDst)
+	})
+
+	t.Run("field resolution for the fallback path is cached like a Mapper's", func(t *testing.T) {
+		calls := 0
+		cfg := DefaultConfig
+		cfg.NameMapper = func(s string) string {
+			calls++
+			return s
+		}
+
+		conv := NewConverter(cfg)
+		for i := 0; i < 3; i++ {
+			var to SimpleTo
+			err := conv.Convert(&exampleFrom, &to)
+			assert.Nil(t, err)
+		}
+
+		// Same field count as TestMapper's equivalent case: one build
+		// resolves all 6 SimpleTo fields plus all 6 SimpleFrom fields.
+		assert.Equal(t, 12, calls)
+	})
+}
+
+func TestMapper(t *testing.T) {
+	t.Run("Map behaves like MapStructEx", func(t *testing.T) {
+		m := NewMapper(DefaultConfig)
+		var to SimpleTo
+		err := m.Map(&exampleFrom, &to)
+		assert.Nil(t, err)
+		assert.Equal(t, expectedTo, to)
+	})
+
+	t.Run("field resolution is only computed once per type pair", func(t *testing.T) {
+		calls := 0
+		cfg := DefaultConfig
+		cfg.NameMapper = func(s string) string {
+			calls++
+			return s
+		}
+
+		m := NewMapper(cfg)
+		for i := 0; i < 3; i++ {
+			var to SimpleTo
+			err := m.Map(&exampleFrom, &to)
+			assert.Nil(t, err)
+		}
+
+		// One build of the mapping resolves all 6 SimpleTo fields plus
+		// all 6 SimpleFrom fields; further calls must not repeat that.
+		assert.Equal(t, 12, calls)
+	})
+
+	t.Run("a missing required field is reported on every call", func(t *testing.T) {
+		type reqFrom struct {
+			Missing int `s2s:",required"`
+		}
+		m := NewMapper(DefaultConfig)
+		for i := 0; i < 2; i++ {
+			err := m.Map(&reqFrom{Missing: 1}, &struct{}{})
+			assert.ErrorIs(t, err, ErrMissingField)
+		}
+	})
+
+	t.Run("field resolution of a nested struct field is cached too", func(t *testing.T) {
+		type innerFrom struct{ X int }
+		type innerTo struct{ X int }
+		type outerFrom struct {
+			Name  string
+			Inner innerFrom
+		}
+		type outerTo struct {
+			Name  string
+			Inner innerTo
+		}
+
+		calls := 0
+		cfg := DefaultConfig
+		cfg.NameMapper = func(s string) string {
+			calls++
+			return s
+		}
+
+		m := NewMapper(cfg)
+		for i := 0; i < 3; i++ {
+			var to outerTo
+			err := m.Map(&outerFrom{Name: "a", Inner: innerFrom{X: 1}}, &to)
+			assert.Nil(t, err)
+		}
+
+		// First call resolves outerFrom/outerTo (2 fields each) and, on
+		// recursing into Inner, innerFrom/innerTo (1 field each): 6 calls
+		// total. Further calls must not repeat either build.
+		assert.Equal(t, 6, calls)
+	})
+}
+
+func TestImplicitConversion(t *testing.T) {
+	type convFrom struct {
+		A int
+		B float32
+		C []byte
+		D myInt
+	}
+	type convTo struct {
+		A int64
+		B float64
+		C string
+		D int
+	}
+
+	t.Run("off by default", func(t *testing.T) {
+		var to convTo
+		err := MapStruct(&convFrom{A: 1, B: 1.5, C: []byte("hi"), D: 2}, &to)
+		assert.Nil(t, err)
+		assert.Equal(t, convTo{}, to)
+	})
+
+	t.Run("converts int, float, []byte and named types when enabled", func(t *testing.T) {
+		cfg := DefaultConfig
+		cfg.AllowImplicitConversion = true
+
+		var to convTo
+		err := MapStructEx(cfg, &convFrom{A: 1, B: 1.5, C: []byte("hi"), D: 2}, &to)
+		assert.Nil(t, err)
+		assert.Equal(t, convTo{A: 1, B: 1.5, C: "hi", D: 2}, to)
+	})
+
+	t.Run("still errors on a truly inconvertible field when SkipFailedConversion is off", func(t *testing.T) {
+		type from struct{ A chan int }
+		type to struct{ A string }
+
+		cfg := DefaultConfig
+		cfg.AllowImplicitConversion = true
+		cfg.SkipFailedConversion = false
+
+		var out to
+		err := MapStructEx(cfg, &from{A: make(chan int)}, &out)
+		assert.ErrorIs(t, err, ErrInvalidConversion)
+	})
+
+	t.Run("applies to slice and map element types too", func(t *testing.T) {
+		type sliceFrom struct{ Xs []int32 }
+		type sliceTo struct{ Xs []int64 }
+		type mapFrom struct{ Xs map[string]int32 }
+		type mapTo struct{ Xs map[string]int64 }
+
+		cfg := DefaultConfig
+		cfg.AllowImplicitConversion = true
+
+		var sliceOut sliceTo
+		err := MapStructEx(cfg, &sliceFrom{Xs: []int32{1, 2, 3}}, &sliceOut)
+		assert.Nil(t, err)
+		assert.Equal(t, sliceTo{Xs: []int64{1, 2, 3}}, sliceOut)
+
+		var mapOut mapTo
+		err = MapStructEx(cfg, &mapFrom{Xs: map[string]int32{"k": 7}}, &mapOut)
+		assert.Nil(t, err)
+		assert.Equal(t, mapTo{Xs: map[string]int64{"k": 7}}, mapOut)
+	})
+}
+
+func BenchmarkMapStruct(b *testing.B) {
+	// Repeated calls reuse the cached field-resolution plan.
+	for i := 0; i < b.N; i++ {
+		var to SimpleTo
+		if err := MapStruct(&exampleFrom, &to); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkMapStructFreshMapperEachCall(b *testing.B) {
+	// Baseline: a new Mapper per call never hits its cache, so every call
+	// re-walks both structs' fields via reflection, same as MapStruct did
+	// before field resolution was memoized.
+	for i := 0; i < b.N; i++ {
+		var to SimpleTo
+		if err := NewMapper(DefaultConfig).Map(&exampleFrom, &to); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
 func TestEx(t *testing.T) {
 	//defCfg := DefaultConfig
 	const testStr = "foo"
@@ -382,4 +800,33 @@ func TestIncludedMappers(t *testing.T) {
 			"b", "a", "b", "a",
 		}, log)
 	})
+
+	t.Run("Built-in NameMappers", func(t *testing.T) {
+		cases := []struct {
+			in                   string
+			snake, camel, pascal string
+			kebab, allCaps       string
+		}{
+			{"HTTPServer", "http_server", "httpServer", "HTTPServer", "http-server", "HTTP_SERVER"},
+			{"UserID", "user_id", "userID", "UserID", "user-id", "USER_ID"},
+			{"ID", "id", "id", "ID", "id", "ID"},
+			{"simpleName", "simple_name", "simpleName", "SimpleName", "simple-name", "SIMPLE_NAME"},
+			{"Bool", "bool", "bool", "Bool", "bool", "BOOL"},
+		}
+
+		for _, c := range cases {
+			assert.Equal(t, c.snake, SnakeCaseMapper(c.in), c.in)
+			assert.Equal(t, c.camel, CamelCaseMapper(c.in), c.in)
+			assert.Equal(t, c.pascal, PascalCaseMapper(c.in), c.in)
+			assert.Equal(t, c.kebab, KebabCaseMapper(c.in), c.in)
+			assert.Equal(t, c.allCaps, AllCapsUnderscoreMapper(c.in), c.in)
+		}
+	})
+
+	t.Run("ChainNameMappers", func(t *testing.T) {
+		mapper := ChainNameMappers(SnakeCaseMapper, CaseInsensitiveMapper)
+		assert.Equal(t, "http_server", mapper("HTTPServer"))
+
+		assert.Equal(t, "UserID", ChainNameMappers()("UserID"))
+	})
 }